@@ -0,0 +1,31 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package baseboard
+
+import (
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/option"
+)
+
+// Info describes the baseboard (motherboard) installed on the host.
+type Info struct {
+	AssetTag     string `json:"asset_tag"`
+	SerialNumber string `json:"serial_number"`
+	Vendor       string `json:"vendor"`
+	Version      string `json:"version"`
+	Product      string `json:"product"`
+}
+
+// New returns an Info struct describing the baseboard installed on the
+// host.
+func New(opts ...*option.Option) (*Info, error) {
+	ctx := context.New(opts...)
+	info := &Info{}
+	if err := info.load(ctx); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
@@ -0,0 +1,23 @@
+//go:build js
+
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package baseboard
+
+import (
+	"errors"
+
+	"github.com/jaypipes/ghw/pkg/context"
+)
+
+// ErrNotSupported is returned by load() on platforms ghw has no native
+// backend for, such as js/wasm, so callers can distinguish "unsupported
+// platform" from a genuine detection failure.
+var ErrNotSupported = errors.New("ghw: not supported on this platform")
+
+func (i *Info) load(ctx *context.Context) error {
+	return ErrNotSupported
+}
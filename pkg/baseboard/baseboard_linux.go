@@ -0,0 +1,39 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package baseboard
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/linuxpath"
+)
+
+// load populates Info by reading /sys/class/dmi/id, the kernel's own
+// decoding of the SMBIOS Type 2 (Baseboard Information) structure.
+func (i *Info) load(ctx *context.Context) error {
+	paths := linuxpath.New(ctx)
+	i.Vendor = readDMIAttr(paths, "board_vendor")
+	i.Product = readDMIAttr(paths, "board_name")
+	i.Version = readDMIAttr(paths, "board_version")
+	i.SerialNumber = readDMIAttr(paths, "board_serial")
+	i.AssetTag = readDMIAttr(paths, "board_asset_tag")
+	return nil
+}
+
+// readDMIAttr returns the contents of the named attribute under
+// paths.SysClassDMIID, or "" if it doesn't exist or can't be read --
+// hosts without DMI support (VMs using some hypervisors, some ARM boards)
+// simply won't expose these files.
+func readDMIAttr(paths *linuxpath.Paths, name string) string {
+	contents, err := ioutil.ReadFile(filepath.Join(paths.SysClassDMIID, name))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(contents))
+}
@@ -0,0 +1,81 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package baseboard
+
+import (
+	"fmt"
+
+	"github.com/StackExchange/wmi"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/smbios"
+)
+
+const wqlBaseBoard = "SELECT Manufacturer, Product, SerialNumber, Version, Tag FROM Win32_BaseBoard"
+
+type win32BaseBoard struct {
+	Manufacturer *string
+	Product      *string
+	SerialNumber *string
+	Version      *string
+	Tag          *string
+}
+
+func (i *Info) load(ctx *context.Context) error {
+	if err := i.loadSMBIOS(); err == nil {
+		return nil
+	}
+	return i.loadWMI()
+}
+
+// loadSMBIOS populates Info by decoding the Type 2 (Baseboard Information)
+// structure from the raw SMBIOS table.
+func (i *Info) loadSMBIOS() error {
+	raw, err := smbios.FetchRawTable()
+	if err != nil {
+		return err
+	}
+	structures, err := smbios.ParseTable(raw)
+	if err != nil {
+		return err
+	}
+	s, found := smbios.Find(structures, smbios.TypeBaseboard)
+	if !found {
+		return fmt.Errorf("no SMBIOS Type 2 (Baseboard Information) structure found")
+	}
+
+	f := s.Formatted
+	if len(f) < 4 {
+		return fmt.Errorf("SMBIOS Type 2 structure is too short to decode")
+	}
+	i.Vendor = s.String(int(f[0]))
+	i.Product = s.String(int(f[1]))
+	i.Version = s.String(int(f[2]))
+	i.SerialNumber = s.String(int(f[3]))
+	if len(f) > 4 {
+		i.AssetTag = s.String(int(f[4]))
+	}
+	return nil
+}
+
+// loadWMI populates Info from Win32_BaseBoard, used when
+// GetSystemFirmwareTable('RSMB', ...) fails -- on locked-down hosts where
+// firmware table access is restricted, for instance.
+func (i *Info) loadWMI() error {
+	var descs []win32BaseBoard
+	if err := wmi.Query(wqlBaseBoard, &descs); err != nil {
+		return err
+	}
+	if len(descs) == 0 {
+		return fmt.Errorf("WMI Win32_BaseBoard query returned no rows")
+	}
+	i.Vendor = *descs[0].Manufacturer
+	i.Product = *descs[0].Product
+	i.Version = *descs[0].Version
+	i.SerialNumber = *descs[0].SerialNumber
+	i.AssetTag = *descs[0].Tag
+	return nil
+}
@@ -0,0 +1,36 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package bios
+
+import (
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/option"
+)
+
+// Info describes the BIOS on the host system.
+type Info struct {
+	Vendor       string `json:"vendor"`
+	Version      string `json:"version"`
+	Date         string `json:"date"`
+	SerialNumber string `json:"serial_number"`
+
+	// Revision is the BIOS release's major.minor version, e.g. "2.17",
+	// distinct from Version which is the OEM's own BIOS version string.
+	Revision string `json:"revision,omitempty"`
+	// ROMSizeBytes is the size of the BIOS ROM, decoded from the SMBIOS
+	// Type 0 structure's ROM Size field.
+	ROMSizeBytes int64 `json:"rom_size_bytes,omitempty"`
+}
+
+// New returns an Info struct describing the BIOS on the host system.
+func New(opts ...*option.Option) (*Info, error) {
+	ctx := context.New(opts...)
+	info := &Info{}
+	if err := info.load(ctx); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
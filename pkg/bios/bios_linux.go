@@ -0,0 +1,41 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package bios
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/linuxpath"
+)
+
+// load populates Info by reading /sys/class/dmi/id, the kernel's own
+// decoding of the same SMBIOS Type 0 structure pkg/smbios reads directly
+// on Windows. As on Windows, the BIOS serial number actually comes from
+// the Type 1 (System Information) structure, exposed here as
+// "product_serial".
+func (i *Info) load(ctx *context.Context) error {
+	paths := linuxpath.New(ctx)
+	i.Vendor = readDMIAttr(paths, "bios_vendor")
+	i.Version = readDMIAttr(paths, "bios_version")
+	i.Date = readDMIAttr(paths, "bios_date")
+	i.SerialNumber = readDMIAttr(paths, "product_serial")
+	return nil
+}
+
+// readDMIAttr returns the contents of the named attribute under
+// paths.SysClassDMIID, or "" if it doesn't exist or can't be read --
+// hosts without DMI support (VMs using some hypervisors, some ARM boards)
+// simply won't expose these files.
+func readDMIAttr(paths *linuxpath.Paths, name string) string {
+	contents, err := ioutil.ReadFile(filepath.Join(paths.SysClassDMIID, name))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(contents))
+}
@@ -6,11 +6,48 @@
 package bios
 
 import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"strings"
+
 	"github.com/StackExchange/wmi"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/smbios"
 )
 
 const wqlBIOS = "SELECT SerialNumber, InstallDate, Manufacturer, Version FROM CIM_BIOSElement"
 
+// biosBackend identifies which mechanism produced the BIOS information.
+type biosBackend string
+
+const (
+	biosBackendSMBIOS     biosBackend = "smbios"
+	biosBackendWMI        biosBackend = "wmi"
+	biosBackendPowerShell biosBackend = "powershell"
+)
+
+// envBIOSBackend, when set to "smbios", "wmi" or "powershell", forces
+// load() to use that backend exclusively instead of falling back
+// automatically. This exists mainly so tests can exercise any one path
+// deterministically.
+const envBIOSBackend = "GHW_BIOS_BACKEND"
+
+// forcedBIOSBackend reads envBIOSBackend and returns the backend it names,
+// or "" if the variable is unset or doesn't name a recognized backend --
+// meaning load() should try every backend in its normal order.
+func forcedBIOSBackend() biosBackend {
+	switch v := biosBackend(strings.ToLower(os.Getenv(envBIOSBackend))); v {
+	case biosBackendSMBIOS, biosBackendWMI, biosBackendPowerShell:
+		return v
+	default:
+		return ""
+	}
+}
+
 type win32BIOS struct {
 	SerialNumber *string
 	InstallDate  *string
@@ -18,17 +55,154 @@ type win32BIOS struct {
 	Version      *string
 }
 
-func (i *Info) load() error {
-	// Getting data from WMI
-	var win32BIOSDescriptions []win32BIOS
-	if err := wmi.Query(wqlBIOS, &win32BIOSDescriptions); err != nil {
+func (i *Info) load(ctx *context.Context) error {
+	forced := forcedBIOSBackend()
+
+	if forced != biosBackendWMI && forced != biosBackendPowerShell {
+		if err := i.loadSMBIOS(); err == nil {
+			log.Printf("ghw: bios information loaded via %s backend", biosBackendSMBIOS)
+			return nil
+		} else if forced == biosBackendSMBIOS {
+			return err
+		}
+	}
+
+	if forced != biosBackendPowerShell {
+		if err := i.loadWMI(); err == nil {
+			log.Printf("ghw: bios information loaded via %s backend", biosBackendWMI)
+			return nil
+		} else if forced == biosBackendWMI {
+			return err
+		}
+	}
+
+	if err := i.loadPowerShell(); err != nil {
+		return err
+	}
+	log.Printf("ghw: bios information loaded via %s backend", biosBackendPowerShell)
+	return nil
+}
+
+// loadSMBIOS populates Info by calling GetSystemFirmwareTable('RSMB', ...)
+// and decoding the Type 0 (BIOS Information) structure from the raw SMBIOS
+// table. Unlike WMI's CIM_BIOSElement, this gives access to the BIOS
+// release date, BIOS revision, and ROM size.
+func (i *Info) loadSMBIOS() error {
+	raw, err := smbios.FetchRawTable()
+	if err != nil {
+		return err
+	}
+	structures, err := smbios.ParseTable(raw)
+	if err != nil {
 		return err
 	}
-	if len(win32BIOSDescriptions) > 0 {
-		i.SerialNumber = *win32BIOSDescriptions[0].SerialNumber
-		i.Vendor = *win32BIOSDescriptions[0].Manufacturer
-		i.Version = *win32BIOSDescriptions[0].Version
-		i.Date = *win32BIOSDescriptions[0].InstallDate
+	s, found := smbios.Find(structures, smbios.TypeBIOS)
+	if !found {
+		return fmt.Errorf("no SMBIOS Type 0 (BIOS Information) structure found")
+	}
+
+	f := s.Formatted
+	if len(f) < 2 {
+		return fmt.Errorf("SMBIOS Type 0 structure is too short to decode")
+	}
+	i.Vendor = s.String(int(f[0]))
+	i.Version = s.String(int(f[1]))
+	if len(f) > 4 {
+		i.Date = s.String(int(f[4]))
 	}
+	if len(f) > 5 && f[5] != 0xFF {
+		i.ROMSizeBytes = (int64(f[5]) + 1) * 64 * 1024
+	}
+	if len(f) > 17 {
+		i.Revision = fmt.Sprintf("%d.%d", f[16], f[17])
+	}
+
+	// The BIOS serial number isn't part of the Type 0 structure -- it's
+	// reported alongside the rest of the system identity in Type 1.
+	if sys, found := smbios.Find(structures, smbios.TypeSystem); found && len(sys.Formatted) > 3 {
+		i.SerialNumber = sys.String(int(sys.Formatted[3]))
+	}
+	return nil
+}
+
+// loadWMI populates Info from CIM_BIOSElement. Unlike loadSMBIOS it can't
+// report BIOS revision or ROM size, but it tends to work in restricted
+// environments (containers, locked-down VMs) where the raw firmware table
+// isn't reachable.
+func (i *Info) loadWMI() error {
+	var descs []win32BIOS
+	if err := wmi.Query(wqlBIOS, &descs); err != nil {
+		return err
+	}
+	if len(descs) == 0 {
+		return fmt.Errorf("WMI CIM_BIOSElement query returned no rows")
+	}
+	i.SerialNumber = *descs[0].SerialNumber
+	i.Vendor = *descs[0].Manufacturer
+	i.Version = *descs[0].Version
+	i.Date = *descs[0].InstallDate
 	return nil
 }
+
+// loadPowerShell is a fallback for hosts where the WMI/COM path is
+// unavailable (RPC/DCOM disabled, a corrupt WMI repository, or a
+// non-admin session). It shells out to PowerShell, preferring the
+// Get-CimInstance cmdlet and falling back to the older Get-WmiObject for
+// PowerShell versions that lack CIM cmdlets, then parses the "Name :
+// Value" formatted output.
+func (i *Info) loadPowerShell() error {
+	out, err := runPowerShellBIOSQuery()
+	if err != nil {
+		return err
+	}
+	return i.parsePowerShellBIOSOutput(out)
+}
+
+// parsePowerShellBIOSOutput scans Format-List's "Name : Value" output for
+// the fields loadPowerShell cares about, writing any it finds into i.
+// Blank values are left untouched rather than overwriting a field with "".
+func (i *Info) parsePowerShellBIOSOutput(out string) error {
+	fields := map[string]*string{
+		"SerialNumber": &i.SerialNumber,
+		"Manufacturer": &i.Vendor,
+		"Version":      &i.Version,
+		"ReleaseDate":  &i.Date,
+	}
+
+	scanner := bufio.NewScanner(strings.NewReader(out))
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		key := strings.TrimSpace(parts[0])
+		val := strings.TrimSpace(parts[1])
+		if dst, ok := fields[key]; ok && val != "" {
+			*dst = val
+		}
+	}
+	return scanner.Err()
+}
+
+// runPowerShellBIOSQuery invokes Get-CimInstance (or, failing that,
+// Get-WmiObject) for the Win32_BIOS class and returns its combined
+// "Name : Value" formatted output.
+func runPowerShellBIOSQuery() (string, error) {
+	cmdlets := []string{
+		"Get-CimInstance -ClassName Win32_BIOS",
+		"Get-WmiObject -Class Win32_BIOS",
+	}
+	var lastErr error
+	for _, cmdlet := range cmdlets {
+		script := fmt.Sprintf(
+			"%s | Select-Object SerialNumber,Manufacturer,Version,ReleaseDate | Format-List",
+			cmdlet,
+		)
+		out, err := exec.Command("powershell.exe", "-NoProfile", "-NonInteractive", "-Command", script).Output()
+		if err == nil {
+			return string(out), nil
+		}
+		lastErr = err
+	}
+	return "", fmt.Errorf("powershell BIOS query failed: %w", lastErr)
+}
@@ -0,0 +1,75 @@
+//go:build windows
+
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package bios
+
+import "testing"
+
+func TestParsePowerShellBIOSOutput(t *testing.T) {
+	out := "\r\n" +
+		"SerialNumber : ABC123\r\n" +
+		"Manufacturer : Acme Inc.\r\n" +
+		"Version      : 1.0\r\n" +
+		"ReleaseDate  : 20240101000000.000000+000\r\n"
+
+	i := &Info{}
+	if err := i.parsePowerShellBIOSOutput(out); err != nil {
+		t.Fatalf("Expected nil err, but got %v", err)
+	}
+	if i.SerialNumber != "ABC123" {
+		t.Errorf("Expected SerialNumber %q, got %q", "ABC123", i.SerialNumber)
+	}
+	if i.Vendor != "Acme Inc." {
+		t.Errorf("Expected Vendor %q, got %q", "Acme Inc.", i.Vendor)
+	}
+	if i.Version != "1.0" {
+		t.Errorf("Expected Version %q, got %q", "1.0", i.Version)
+	}
+	if i.Date != "20240101000000.000000+000" {
+		t.Errorf("Expected Date %q, got %q", "20240101000000.000000+000", i.Date)
+	}
+}
+
+func TestParsePowerShellBIOSOutputBlankAndUnknownFields(t *testing.T) {
+	out := "SerialNumber : \r\n" +
+		"Manufacturer : Acme Inc.\r\n" +
+		"SomeOtherField : whatever\r\n" +
+		"not a field line\r\n"
+
+	i := &Info{SerialNumber: "unchanged"}
+	if err := i.parsePowerShellBIOSOutput(out); err != nil {
+		t.Fatalf("Expected nil err, but got %v", err)
+	}
+	if i.SerialNumber != "unchanged" {
+		t.Errorf("Expected a blank value to leave SerialNumber untouched, got %q", i.SerialNumber)
+	}
+	if i.Vendor != "Acme Inc." {
+		t.Errorf("Expected Vendor %q, got %q", "Acme Inc.", i.Vendor)
+	}
+}
+
+func TestForcedBIOSBackend(t *testing.T) {
+	tests := []struct {
+		envVal string
+		want   biosBackend
+	}{
+		{"", ""},
+		{"smbios", biosBackendSMBIOS},
+		{"WMI", biosBackendWMI},
+		{"PowerShell", biosBackendPowerShell},
+		{"bogus", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.envVal, func(t *testing.T) {
+			t.Setenv(envBIOSBackend, tt.envVal)
+			if got := forcedBIOSBackend(); got != tt.want {
+				t.Errorf("Expected %q, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package block
+
+import (
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/option"
+)
+
+// Disk describes a single block storage device on the host.
+type Disk struct {
+	Name      string `json:"name"`
+	SizeBytes int64  `json:"size_bytes"`
+	DriveType string `json:"drive_type"`
+}
+
+// Info describes the block storage devices on the host system.
+type Info struct {
+	TotalPhysicalBytes int64   `json:"total_physical_bytes"`
+	Disks              []*Disk `json:"disks"`
+}
+
+// New returns an Info struct describing the block storage devices on the
+// host system.
+func New(opts ...*option.Option) (*Info, error) {
+	ctx := context.New(opts...)
+	info := &Info{}
+	if err := info.load(ctx); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
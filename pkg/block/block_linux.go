@@ -0,0 +1,87 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package block
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/linuxpath"
+)
+
+// sysfsSectorSize is the fixed unit, in bytes, that a block device's sysfs
+// "size" attribute is expressed in, regardless of the device's actual
+// logical block size. See Documentation/block/stat.rst in the kernel tree.
+const sysfsSectorSize = 512
+
+func (i *Info) load(ctx *context.Context) error {
+	paths := linuxpath.New(ctx)
+	i.Disks = disks(ctx, paths)
+	for _, d := range i.Disks {
+		i.TotalPhysicalBytes += d.SizeBytes
+	}
+	return nil
+}
+
+// disks walks paths.SysBlock -- which honours the Context's chroot -- and
+// builds a Disk for each non-virtual block device found there.
+func disks(ctx *context.Context, paths *linuxpath.Paths) []*Disk {
+	disks := make([]*Disk, 0)
+
+	entries, err := ioutil.ReadDir(paths.SysBlock)
+	if err != nil {
+		ctx.Warn("failed to read %s: %s", paths.SysBlock, err)
+		return disks
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		if isVirtualDisk(name) {
+			continue
+		}
+		disks = append(disks, diskForDevice(paths.SysBlock, name))
+	}
+	return disks
+}
+
+// isVirtualDisk returns true for block devices, such as loop and ram
+// devices, that don't correspond to real storage hardware.
+func isVirtualDisk(name string) bool {
+	return strings.HasPrefix(name, "loop") || strings.HasPrefix(name, "ram")
+}
+
+// diskForDevice reads the sysfs attributes for a single /sys/block entry
+// and returns the Disk describing it.
+func diskForDevice(sysBlock, name string) *Disk {
+	devPath := filepath.Join(sysBlock, name)
+
+	disk := &Disk{
+		Name:      name,
+		DriveType: "HDD",
+	}
+
+	if size, err := strconv.ParseInt(readSysfsLine(filepath.Join(devPath, "size")), 10, 64); err == nil {
+		disk.SizeBytes = size * sysfsSectorSize
+	}
+	if readSysfsLine(filepath.Join(devPath, "queue", "rotational")) == "0" {
+		disk.DriveType = "SSD"
+	}
+
+	return disk
+}
+
+// readSysfsLine returns the first line of the named sysfs file, or "" if
+// the file doesn't exist or can't be read.
+func readSysfsLine(path string) string {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(strings.SplitN(string(contents), "\n", 2)[0])
+}
@@ -0,0 +1,77 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package chassis
+
+import (
+	"strconv"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/option"
+)
+
+// Info describes the chassis the host is housed in.
+type Info struct {
+	AssetTag string `json:"asset_tag"`
+	// SerialNumber is the chassis' own serial number, distinct from the
+	// overall system serial number reported by pkg/product.
+	SerialNumber string `json:"serial_number"`
+	// Type is the numeric SMBIOS chassis type code, e.g. "3" for Desktop.
+	Type string `json:"type"`
+	// TypeDescription is the human-readable name for Type, e.g. "Desktop".
+	TypeDescription string `json:"type_description"`
+	Vendor          string `json:"vendor"`
+	Version         string `json:"version"`
+}
+
+// chassisTypeDescriptions maps the SMBIOS Type 3 "Type" field's low 7 bits
+// to its human-readable name. See the SMBIOS spec's "System Enclosure or
+// Chassis Types" table.
+var chassisTypeDescriptions = map[uint8]string{
+	0x01: "Other",
+	0x02: "Unknown",
+	0x03: "Desktop",
+	0x04: "Low Profile Desktop",
+	0x05: "Pizza Box",
+	0x06: "Mini Tower",
+	0x07: "Tower",
+	0x08: "Portable",
+	0x09: "Laptop",
+	0x0A: "Notebook",
+	0x0B: "Hand Held",
+	0x0C: "Docking Station",
+	0x0D: "All in One",
+	0x0E: "Sub Notebook",
+	0x0F: "Space-saving",
+	0x10: "Lunch Box",
+	0x11: "Main Server Chassis",
+	0x12: "Expansion Chassis",
+	0x13: "SubChassis",
+	0x14: "Bus Expansion Chassis",
+	0x15: "Peripheral Chassis",
+	0x16: "RAID Chassis",
+	0x17: "Rack Mount Chassis",
+	0x18: "Sealed-case PC",
+	0x1E: "Tablet",
+	0x1F: "Convertible",
+	0x20: "Detachable",
+}
+
+// setType sets Type and TypeDescription from the raw SMBIOS chassis type
+// code, shared by every backend that decodes one.
+func (i *Info) setType(code uint8) {
+	i.Type = strconv.Itoa(int(code))
+	i.TypeDescription = chassisTypeDescriptions[code]
+}
+
+// New returns an Info struct describing the chassis the host is housed in.
+func New(opts ...*option.Option) (*Info, error) {
+	ctx := context.New(opts...)
+	info := &Info{}
+	if err := info.load(ctx); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
@@ -0,0 +1,42 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package chassis
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/linuxpath"
+)
+
+// load populates Info by reading /sys/class/dmi/id, the kernel's own
+// decoding of the SMBIOS Type 3 (Chassis Information) structure.
+func (i *Info) load(ctx *context.Context) error {
+	paths := linuxpath.New(ctx)
+	i.Vendor = readDMIAttr(paths, "chassis_vendor")
+	i.Version = readDMIAttr(paths, "chassis_version")
+	i.SerialNumber = readDMIAttr(paths, "chassis_serial")
+	i.AssetTag = readDMIAttr(paths, "chassis_asset_tag")
+	if code, err := strconv.Atoi(readDMIAttr(paths, "chassis_type")); err == nil {
+		i.setType(uint8(code) & 0x7F)
+	}
+	return nil
+}
+
+// readDMIAttr returns the contents of the named attribute under
+// paths.SysClassDMIID, or "" if it doesn't exist or can't be read --
+// hosts without DMI support (VMs using some hypervisors, some ARM boards)
+// simply won't expose these files.
+func readDMIAttr(paths *linuxpath.Paths, name string) string {
+	contents, err := ioutil.ReadFile(filepath.Join(paths.SysClassDMIID, name))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(contents))
+}
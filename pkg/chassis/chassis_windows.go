@@ -0,0 +1,81 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package chassis
+
+import (
+	"fmt"
+
+	"github.com/StackExchange/wmi"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/smbios"
+)
+
+const wqlSystemEnclosure = "SELECT Manufacturer, SerialNumber, SMBIOSAssetTag, ChassisTypes FROM Win32_SystemEnclosure"
+
+type win32SystemEnclosure struct {
+	Manufacturer   *string
+	SerialNumber   *string
+	SMBIOSAssetTag *string
+	ChassisTypes   []uint16
+}
+
+func (i *Info) load(ctx *context.Context) error {
+	if err := i.loadSMBIOS(); err == nil {
+		return nil
+	}
+	return i.loadWMI()
+}
+
+// loadSMBIOS populates Info by decoding the Type 3 (Chassis Information)
+// structure from the raw SMBIOS table.
+func (i *Info) loadSMBIOS() error {
+	raw, err := smbios.FetchRawTable()
+	if err != nil {
+		return err
+	}
+	structures, err := smbios.ParseTable(raw)
+	if err != nil {
+		return err
+	}
+	s, found := smbios.Find(structures, smbios.TypeChassis)
+	if !found {
+		return fmt.Errorf("no SMBIOS Type 3 (Chassis Information) structure found")
+	}
+
+	f := s.Formatted
+	if len(f) < 4 {
+		return fmt.Errorf("SMBIOS Type 3 structure is too short to decode")
+	}
+	i.Vendor = s.String(int(f[0]))
+	i.setType(f[1] & 0x7F)
+	i.Version = s.String(int(f[2]))
+	i.SerialNumber = s.String(int(f[3]))
+	if len(f) > 4 {
+		i.AssetTag = s.String(int(f[4]))
+	}
+	return nil
+}
+
+// loadWMI populates Info from Win32_SystemEnclosure's ChassisTypes array,
+// used when the SMBIOS firmware table is unreachable. Only the first
+// reported chassis type is kept, matching loadSMBIOS.
+func (i *Info) loadWMI() error {
+	var descs []win32SystemEnclosure
+	if err := wmi.Query(wqlSystemEnclosure, &descs); err != nil {
+		return err
+	}
+	if len(descs) == 0 {
+		return fmt.Errorf("WMI Win32_SystemEnclosure query returned no rows")
+	}
+	i.Vendor = *descs[0].Manufacturer
+	i.SerialNumber = *descs[0].SerialNumber
+	i.AssetTag = *descs[0].SMBIOSAssetTag
+	if len(descs[0].ChassisTypes) > 0 {
+		i.setType(uint8(descs[0].ChassisTypes[0]) & 0x7F)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+// Package context defines the Context that ghw's subsystem packages thread
+// through their load() implementations. It carries cross-cutting options
+// supplied via pkg/option -- currently just the chroot prefix -- along with
+// a warning sink used to report non-fatal detection failures.
+package context
+
+import (
+	"log"
+
+	"github.com/jaypipes/ghw/pkg/option"
+)
+
+// Context contains options common to all ghw subsystem implementations.
+type Context struct {
+	// Chroot is the root directory that Linux backends treat as "/" when
+	// reading from sysfs/procfs. It defaults to "/" and is overridden via
+	// option.WithChroot.
+	Chroot string
+}
+
+// New returns a Context configured from the supplied Options.
+func New(opts ...*option.Option) *Context {
+	merged := option.Merge(opts...)
+	return &Context{
+		Chroot: *merged.Chroot,
+	}
+}
+
+// FromEnv returns a Context configured with ghw's defaults. It exists for
+// callers that don't need to pass any Options of their own.
+func FromEnv() *Context {
+	return New()
+}
+
+// Warn logs a non-fatal warning encountered while probing the host, such as
+// a sysfs file that was expected but missing.
+func (ctx *Context) Warn(msg string, args ...interface{}) {
+	log.Printf("ghw warning: "+msg, args...)
+}
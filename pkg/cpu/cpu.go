@@ -0,0 +1,37 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package cpu
+
+import (
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/option"
+)
+
+// Processor describes a single physical processor (socket) on the host.
+type Processor struct {
+	Vendor     string `json:"vendor"`
+	Model      string `json:"model"`
+	NumCores   uint32 `json:"num_cores"`
+	NumThreads uint32 `json:"num_threads"`
+}
+
+// Info describes the processors installed on the host.
+type Info struct {
+	TotalCores   uint32       `json:"total_cores"`
+	TotalThreads uint32       `json:"total_threads"`
+	Processors   []*Processor `json:"processors"`
+}
+
+// New returns an Info struct describing the processors installed on the
+// host.
+func New(opts ...*option.Option) (*Info, error) {
+	ctx := context.New(opts...)
+	info := &Info{}
+	if err := info.load(ctx); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
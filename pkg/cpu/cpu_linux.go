@@ -0,0 +1,106 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package cpu
+
+import (
+	"bufio"
+	"os"
+	"strings"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/linuxpath"
+)
+
+func (i *Info) load(ctx *context.Context) error {
+	paths := linuxpath.New(ctx)
+	f, err := os.Open(paths.ProcCpuinfo)
+	if err != nil {
+		ctx.Warn("failed to read %s: %s", paths.ProcCpuinfo, err)
+		return nil
+	}
+	defer f.Close()
+
+	for _, p := range processorsFromCpuinfo(f) {
+		i.Processors = append(i.Processors, p)
+		i.TotalCores += p.NumCores
+		i.TotalThreads += p.NumThreads
+	}
+	return nil
+}
+
+// processorsFromCpuinfo groups /proc/cpuinfo's logical-processor records
+// by "physical id" -- one socket, one Processor -- counting NumThreads as
+// the number of logical processors sharing that physical id and NumCores
+// as the number of distinct "core id" values among them. Systems that
+// don't report "physical id" (some VMs, single-socket ARM boards) are
+// treated as a single socket.
+func processorsFromCpuinfo(r *os.File) []*Processor {
+	var order []string
+	byPhysID := map[string]*Processor{}
+	coresSeen := map[string]map[string]bool{}
+
+	physID, vendor, model, coreID := "0", "", "", ""
+	flush := func() {
+		p, ok := byPhysID[physID]
+		if !ok {
+			p = &Processor{Vendor: vendor, Model: model}
+			byPhysID[physID] = p
+			coresSeen[physID] = map[string]bool{}
+			order = append(order, physID)
+		}
+		p.NumThreads++
+		if coreID != "" && !coresSeen[physID][coreID] {
+			coresSeen[physID][coreID] = true
+			p.NumCores++
+		}
+	}
+
+	scanner := bufio.NewScanner(r)
+	haveRecord := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.TrimSpace(line) == "" {
+			if haveRecord {
+				flush()
+				haveRecord = false
+				physID, coreID = "0", ""
+			}
+			continue
+		}
+		parts := strings.SplitN(line, ":", 2)
+		if len(parts) != 2 {
+			continue
+		}
+		key := strings.TrimSpace(parts[0])
+		val := strings.TrimSpace(parts[1])
+		switch key {
+		case "processor":
+			haveRecord = true
+		case "vendor_id":
+			vendor = val
+		case "model name":
+			model = val
+		case "physical id":
+			physID = val
+		case "core id":
+			coreID = val
+		}
+	}
+	if haveRecord {
+		flush()
+	}
+
+	processors := make([]*Processor, 0, len(order))
+	for _, id := range order {
+		if byPhysID[id].NumCores == 0 {
+			// No "core id" field was present for this socket -- assume
+			// every logical processor is its own core.
+			byPhysID[id].NumCores = byPhysID[id].NumThreads
+		}
+		processors = append(processors, byPhysID[id])
+	}
+	return processors
+}
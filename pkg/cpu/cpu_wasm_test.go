@@ -0,0 +1,26 @@
+//go:build wasip1 || js
+
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package cpu_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jaypipes/ghw/pkg/cpu"
+)
+
+func TestCPUUnsupported(t *testing.T) {
+	info, err := cpu.New()
+
+	if !errors.Is(err, cpu.ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, but got %v", err)
+	}
+	if info != nil {
+		t.Fatalf("Expected nil Info, but got %v", info)
+	}
+}
@@ -0,0 +1,112 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package cpu
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/StackExchange/wmi"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/smbios"
+)
+
+const wqlProcessor = "SELECT Manufacturer, Name, NumberOfCores, NumberOfLogicalProcessors FROM Win32_Processor"
+
+type win32Processor struct {
+	Manufacturer              *string
+	Name                      *string
+	NumberOfCores             *uint32
+	NumberOfLogicalProcessors *uint32
+}
+
+func (i *Info) load(ctx *context.Context) error {
+	if err := i.loadSMBIOS(); err == nil {
+		return nil
+	}
+	return i.loadWMI()
+}
+
+// loadSMBIOS populates Info by decoding every Type 4 (Processor
+// Information) structure -- one per socket -- from the raw SMBIOS table.
+func (i *Info) loadSMBIOS() error {
+	raw, err := smbios.FetchRawTable()
+	if err != nil {
+		return err
+	}
+	structures, err := smbios.ParseTable(raw)
+	if err != nil {
+		return err
+	}
+	entries := smbios.FindAll(structures, smbios.TypeProcessor)
+	if len(entries) == 0 {
+		return fmt.Errorf("no SMBIOS Type 4 (Processor Information) structures found")
+	}
+
+	for _, s := range entries {
+		f := s.Formatted
+		if len(f) <= 33 {
+			// Structure predates the 2.5 Core/Thread Count fields; skip
+			// rather than guess.
+			continue
+		}
+		p := &Processor{
+			Vendor:     s.String(int(f[3])),
+			Model:      s.String(int(f[12])),
+			NumCores:   countField(f, 31, 38),
+			NumThreads: countField(f, 33, 42),
+		}
+		i.Processors = append(i.Processors, p)
+		i.TotalCores += p.NumCores
+		i.TotalThreads += p.NumThreads
+	}
+	if len(i.Processors) == 0 {
+		return fmt.Errorf("no SMBIOS Type 4 structures were new enough to decode core/thread counts")
+	}
+	return nil
+}
+
+// countField reads the single-byte Core/Thread Count field at byteIdx,
+// transparently following the 0xFF "see the extended field" sentinel the
+// SMBIOS spec defines for systems with 255 or more cores/threads to the
+// corresponding 2-byte Core Count 2/Thread Count 2 field at extIdx.
+func countField(f []byte, byteIdx, extIdx int) uint32 {
+	n := f[byteIdx]
+	if n != 0xFF {
+		return uint32(n)
+	}
+	if len(f) < extIdx+2 {
+		return 0
+	}
+	return uint32(binary.LittleEndian.Uint16(f[extIdx : extIdx+2]))
+}
+
+// loadWMI populates Info from Win32_Processor, one row per socket, used
+// when the SMBIOS firmware table is unreachable. It reports core and
+// thread counts directly, so there's no 0xFF extended-field handling to
+// worry about here the way there is in loadSMBIOS.
+func (i *Info) loadWMI() error {
+	var descs []win32Processor
+	if err := wmi.Query(wqlProcessor, &descs); err != nil {
+		return err
+	}
+	if len(descs) == 0 {
+		return fmt.Errorf("WMI Win32_Processor query returned no rows")
+	}
+	for _, d := range descs {
+		p := &Processor{
+			Vendor:     *d.Manufacturer,
+			Model:      *d.Name,
+			NumCores:   *d.NumberOfCores,
+			NumThreads: *d.NumberOfLogicalProcessors,
+		}
+		i.Processors = append(i.Processors, p)
+		i.TotalCores += p.NumCores
+		i.TotalThreads += p.NumThreads
+	}
+	return nil
+}
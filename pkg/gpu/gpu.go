@@ -0,0 +1,33 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package gpu
+
+import (
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/option"
+)
+
+// GraphicsCard describes a single GPU installed on the host.
+type GraphicsCard struct {
+	Address string `json:"address"`
+	Index   int    `json:"index"`
+}
+
+// Info describes the graphics cards installed on the host system.
+type Info struct {
+	GraphicsCards []*GraphicsCard `json:"cards"`
+}
+
+// New returns an Info struct describing the graphics cards installed on
+// the host system.
+func New(opts ...*option.Option) (*Info, error) {
+	ctx := context.New(opts...)
+	info := &Info{}
+	if err := info.load(ctx); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
@@ -0,0 +1,56 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package gpu
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/linuxpath"
+)
+
+func (i *Info) load(ctx *context.Context) error {
+	paths := linuxpath.New(ctx)
+	i.GraphicsCards = graphicsCards(ctx, paths)
+	return nil
+}
+
+// graphicsCards walks paths.SysClassDRM -- which honours the Context's
+// chroot -- and builds a GraphicsCard for each "cardN" entry found there.
+// Other DRM entries, such as "renderD*" nodes, aren't graphics cards in
+// their own right and are skipped.
+func graphicsCards(ctx *context.Context, paths *linuxpath.Paths) []*GraphicsCard {
+	cards := make([]*GraphicsCard, 0)
+
+	entries, err := ioutil.ReadDir(paths.SysClassDRM)
+	if err != nil {
+		ctx.Warn("failed to read %s: %s", paths.SysClassDRM, err)
+		return cards
+	}
+
+	for _, entry := range entries {
+		name := entry.Name()
+		idxStr := strings.TrimPrefix(name, "card")
+		if idxStr == name {
+			continue
+		}
+		idx, err := strconv.Atoi(idxStr)
+		if err != nil {
+			continue
+		}
+
+		card := &GraphicsCard{Index: idx}
+		if target, err := os.Readlink(filepath.Join(paths.SysClassDRM, name, "device")); err == nil {
+			card.Address = filepath.Base(target)
+		}
+		cards = append(cards, card)
+	}
+	return cards
+}
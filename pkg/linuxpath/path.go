@@ -0,0 +1,42 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+// Package linuxpath centralizes the sysfs/procfs locations ghw's Linux
+// backends read from, all rooted at the supplied Context's chroot so that
+// tests can point them at a captured filesystem snapshot.
+package linuxpath
+
+import (
+	"path/filepath"
+
+	"github.com/jaypipes/ghw/pkg/context"
+)
+
+// Paths holds the chroot-aware filesystem locations ghw's Linux backends
+// read from.
+type Paths struct {
+	SysClassNet          string
+	SysBlock             string
+	SysClassDRM          string
+	SysBusPCIDevices     string
+	SysDevicesSystemNode string
+	SysClassDMIID        string
+	ProcMeminfo          string
+	ProcCpuinfo          string
+}
+
+// New returns a Paths rooted at ctx.Chroot.
+func New(ctx *context.Context) *Paths {
+	return &Paths{
+		SysClassNet:          filepath.Join(ctx.Chroot, "sys", "class", "net"),
+		SysBlock:             filepath.Join(ctx.Chroot, "sys", "block"),
+		SysClassDRM:          filepath.Join(ctx.Chroot, "sys", "class", "drm"),
+		SysBusPCIDevices:     filepath.Join(ctx.Chroot, "sys", "bus", "pci", "devices"),
+		SysDevicesSystemNode: filepath.Join(ctx.Chroot, "sys", "devices", "system", "node"),
+		SysClassDMIID:        filepath.Join(ctx.Chroot, "sys", "class", "dmi", "id"),
+		ProcMeminfo:          filepath.Join(ctx.Chroot, "proc", "meminfo"),
+		ProcCpuinfo:          filepath.Join(ctx.Chroot, "proc", "cpuinfo"),
+	}
+}
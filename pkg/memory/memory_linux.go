@@ -0,0 +1,56 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package memory
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/linuxpath"
+)
+
+func (i *Info) load(ctx *context.Context) error {
+	paths := linuxpath.New(ctx)
+	totalKB, availKB, err := readMeminfo(paths.ProcMeminfo)
+	if err != nil {
+		ctx.Warn("failed to read %s: %s", paths.ProcMeminfo, err)
+		return nil
+	}
+	i.TotalPhysicalBytes = totalKB * 1024
+	i.TotalUsableBytes = availKB * 1024
+	return nil
+}
+
+// readMeminfo reads the "MemTotal" and "MemAvailable" fields, in KB, out
+// of a /proc/meminfo file. MemAvailable -- an estimate of memory available
+// for new workloads without swapping, accounting for reclaimable caches --
+// is what Info.TotalUsableBytes reports, since plain MemFree undercounts
+// memory the kernel would happily give back under pressure.
+func readMeminfo(path string) (totalKB, availKB int64, err error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 2 {
+			continue
+		}
+		switch strings.TrimSuffix(fields[0], ":") {
+		case "MemTotal":
+			totalKB, _ = strconv.ParseInt(fields[1], 10, 64)
+		case "MemAvailable":
+			availKB, _ = strconv.ParseInt(fields[1], 10, 64)
+		}
+	}
+	return totalKB, availKB, scanner.Err()
+}
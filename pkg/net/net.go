@@ -0,0 +1,46 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package net
+
+import (
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/option"
+)
+
+// NIC describes a single network interface controller on the host.
+type NIC struct {
+	Name       string `json:"name"`
+	MacAddress string `json:"mac_address"`
+	IsVirtual  bool   `json:"is_virtual"`
+	Speed      string `json:"speed,omitempty"`
+	Duplex     string `json:"duplex,omitempty"`
+	PCIAddress string `json:"pci_address,omitempty"`
+
+	// IsBondMaster is true when this NIC is a bonding master aggregating
+	// the NICs listed in BondSlaves. Bridge masters are not flagged here.
+	IsBondMaster bool     `json:"is_bond_master,omitempty"`
+	BondSlaves   []string `json:"bond_slaves,omitempty"`
+
+	// BondMaster, when non-empty, names the bonding (or bridge) master
+	// this NIC is enslaved to.
+	BondMaster string `json:"bond_master,omitempty"`
+}
+
+// Info describes the network interface controllers detected on the host.
+type Info struct {
+	NICs []*NIC `json:"nics"`
+}
+
+// New returns an Info struct describing the network interface controllers
+// on the host system.
+func New(opts ...*option.Option) (*Info, error) {
+	ctx := context.New(opts...)
+	info := &Info{}
+	if err := info.load(ctx); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
@@ -0,0 +1,100 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package net
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/linuxpath"
+)
+
+func (i *Info) load(ctx *context.Context) error {
+	paths := linuxpath.New(ctx)
+	i.NICs = nics(ctx, paths)
+	return nil
+}
+
+// nics walks paths.SysClassNet -- which honours the Context's chroot, so
+// tests can point it at a captured /sys/class/net tree instead of the live
+// host -- and builds a NIC for each entry found there.
+func nics(ctx *context.Context, paths *linuxpath.Paths) []*NIC {
+	nics := make([]*NIC, 0)
+
+	entries, err := ioutil.ReadDir(paths.SysClassNet)
+	if err != nil {
+		ctx.Warn("failed to read %s: %s", paths.SysClassNet, err)
+		return nics
+	}
+
+	for _, entry := range entries {
+		nics = append(nics, nicForDevice(paths.SysClassNet, entry.Name()))
+	}
+	return nics
+}
+
+// nicForDevice reads the sysfs attributes for a single /sys/class/net
+// entry and returns the NIC describing it.
+func nicForDevice(sysClassNet, name string) *NIC {
+	devPath := filepath.Join(sysClassNet, name)
+
+	nic := &NIC{
+		Name:       name,
+		MacAddress: readSysfsLine(filepath.Join(devPath, "address")),
+		Speed:      readSysfsLine(filepath.Join(devPath, "speed")),
+		Duplex:     readSysfsLine(filepath.Join(devPath, "duplex")),
+	}
+
+	if pciAddr, ok := resolveSymlinkBase(filepath.Join(devPath, "device")); ok {
+		nic.PCIAddress = pciAddr
+	} else {
+		nic.IsVirtual = true
+	}
+
+	if slaves := readSysfsLine(filepath.Join(devPath, "bonding", "slaves")); slaves != "" {
+		nic.IsBondMaster = true
+		nic.BondSlaves = strings.Fields(slaves)
+	}
+
+	if master, ok := resolveSymlinkBase(filepath.Join(devPath, "master")); ok {
+		nic.BondMaster = master
+	}
+
+	return nic
+}
+
+// readSysfsLine returns the first line of the named sysfs file, or "" if
+// the file doesn't exist or can't be read.
+func readSysfsLine(path string) string {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(strings.SplitN(string(contents), "\n", 2)[0])
+}
+
+// resolveSymlinkBase resolves path as a symlink and returns the base name
+// of its target, e.g. the PCI address a "device" symlink points to, or the
+// bonding/bridge master name a "master" symlink points to. If path is a
+// regular file instead, as in the captured trees under testdata/, its
+// contents are taken as the link target.
+func resolveSymlinkBase(path string) (string, bool) {
+	target, err := os.Readlink(path)
+	if err != nil {
+		contents, readErr := ioutil.ReadFile(path)
+		if readErr != nil {
+			return "", false
+		}
+		target = strings.TrimSpace(string(contents))
+		if target == "" {
+			return "", false
+		}
+	}
+	return filepath.Base(target), true
+}
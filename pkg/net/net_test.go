@@ -1,3 +1,5 @@
+//go:build linux
+
 //
 // Use and distribution licensed under the Apache license version 2.
 //
@@ -7,26 +9,116 @@
 package net_test
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/jaypipes/ghw/pkg/net"
+	"github.com/jaypipes/ghw/pkg/option"
 )
 
-func TestNet(t *testing.T) {
-	info, err := net.New()
+// wantNIC is the subset of net.NIC fields a testdata fixture asserts.
+type wantNIC struct {
+	name         string
+	macAddress   string
+	speed        string
+	duplex       string
+	pciAddress   string
+	isVirtual    bool
+	isBondMaster bool
+	bondMaster   string
+	bondSlaves   []string
+}
 
-	if err != nil {
-		t.Fatalf("Expected nil err, but got %v", err)
-	}
-	if info == nil {
-		t.Fatalf("Expected non-nil NetworkInfo, but got nil")
+func TestNet(t *testing.T) {
+	tests := []struct {
+		// fixture names the directory under testdata/ containing a
+		// captured /sys/class/net tree.
+		fixture string
+		want    []wantNIC
+	}{
+		{
+			fixture: "physical",
+			want: []wantNIC{
+				{name: "eth0", macAddress: "aa:bb:cc:dd:ee:01", speed: "1000", duplex: "full", pciAddress: "0000:00:19.0"},
+			},
+		},
+		{
+			fixture: "bonded",
+			want: []wantNIC{
+				{name: "bond0", macAddress: "aa:bb:cc:dd:ee:10", isVirtual: true, isBondMaster: true, bondSlaves: []string{"eth0", "eth1"}},
+				{name: "eth0", macAddress: "aa:bb:cc:dd:ee:11", speed: "1000", duplex: "full", pciAddress: "0000:00:19.0", bondMaster: "bond0"},
+				{name: "eth1", macAddress: "aa:bb:cc:dd:ee:12", speed: "1000", duplex: "full", pciAddress: "0000:00:19.1", bondMaster: "bond0"},
+			},
+		},
+		{
+			fixture: "bridge",
+			want: []wantNIC{
+				{name: "br0", macAddress: "aa:bb:cc:dd:ee:20", isVirtual: true},
+				{name: "eth0", macAddress: "aa:bb:cc:dd:ee:21", speed: "1000", duplex: "full", pciAddress: "0000:00:19.0", bondMaster: "br0"},
+			},
+		},
+		{
+			fixture: "vlan",
+			want: []wantNIC{
+				{name: "eth0", macAddress: "aa:bb:cc:dd:ee:30", speed: "1000", duplex: "full", pciAddress: "0000:00:19.0"},
+				{name: "eth0.100", macAddress: "aa:bb:cc:dd:ee:30", isVirtual: true},
+			},
+		},
+		{
+			fixture: "vf",
+			want: []wantNIC{
+				{name: "eth0", macAddress: "aa:bb:cc:dd:ee:40", speed: "10000", duplex: "full", pciAddress: "0000:01:00.0"},
+				{name: "eth0v0", macAddress: "aa:bb:cc:dd:ee:41", pciAddress: "0000:01:00.1"},
+			},
+		},
 	}
 
-	if len(info.NICs) > 0 {
-		for _, n := range info.NICs {
-			if n.Name == "" {
-				t.Fatalf("Expected a NIC name but got \"\".")
+	for _, tt := range tests {
+		t.Run(tt.fixture, func(t *testing.T) {
+			root := filepath.Join("testdata", tt.fixture)
+			info, err := net.New(option.WithChroot(root))
+			if err != nil {
+				t.Fatalf("Expected nil err, but got %v", err)
+			}
+			if len(info.NICs) != len(tt.want) {
+				t.Fatalf("Expected %d NICs, but got %d", len(tt.want), len(info.NICs))
+			}
+
+			byName := make(map[string]*net.NIC, len(info.NICs))
+			for _, n := range info.NICs {
+				byName[n.Name] = n
+			}
+
+			for _, want := range tt.want {
+				got, ok := byName[want.name]
+				if !ok {
+					t.Fatalf("Expected a NIC named %q, but found none", want.name)
+				}
+				if got.MacAddress != want.macAddress {
+					t.Errorf("%s: expected MacAddress %q, got %q", want.name, want.macAddress, got.MacAddress)
+				}
+				if got.Speed != want.speed {
+					t.Errorf("%s: expected Speed %q, got %q", want.name, want.speed, got.Speed)
+				}
+				if got.Duplex != want.duplex {
+					t.Errorf("%s: expected Duplex %q, got %q", want.name, want.duplex, got.Duplex)
+				}
+				if got.PCIAddress != want.pciAddress {
+					t.Errorf("%s: expected PCIAddress %q, got %q", want.name, want.pciAddress, got.PCIAddress)
+				}
+				if got.IsVirtual != want.isVirtual {
+					t.Errorf("%s: expected IsVirtual %v, got %v", want.name, want.isVirtual, got.IsVirtual)
+				}
+				if got.IsBondMaster != want.isBondMaster {
+					t.Errorf("%s: expected IsBondMaster %v, got %v", want.name, want.isBondMaster, got.IsBondMaster)
+				}
+				if got.BondMaster != want.bondMaster {
+					t.Errorf("%s: expected BondMaster %q, got %q", want.name, want.bondMaster, got.BondMaster)
+				}
+				if len(want.bondSlaves) > 0 && filepath.Join(want.bondSlaves...) != filepath.Join(got.BondSlaves...) {
+					t.Errorf("%s: expected BondSlaves %v, got %v", want.name, want.bondSlaves, got.BondSlaves)
+				}
 			}
-		}
+		})
 	}
 }
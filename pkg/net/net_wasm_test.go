@@ -0,0 +1,26 @@
+//go:build wasip1 || js
+
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package net_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jaypipes/ghw/pkg/net"
+)
+
+func TestNetUnsupported(t *testing.T) {
+	info, err := net.New()
+
+	if !errors.Is(err, net.ErrNotSupported) {
+		t.Fatalf("Expected ErrNotSupported, but got %v", err)
+	}
+	if info != nil {
+		t.Fatalf("Expected nil Info, but got %v", info)
+	}
+}
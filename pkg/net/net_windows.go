@@ -0,0 +1,45 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package net
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/StackExchange/wmi"
+
+	"github.com/jaypipes/ghw/pkg/context"
+)
+
+const wqlNetworkAdapter = "SELECT Name, MACAddress, Speed, PhysicalAdapter FROM Win32_NetworkAdapter WHERE MACAddress IS NOT NULL"
+
+type win32NetworkAdapter struct {
+	Name            *string
+	MACAddress      *string
+	Speed           *uint64
+	PhysicalAdapter *bool
+}
+
+func (i *Info) load(ctx *context.Context) error {
+	var descs []win32NetworkAdapter
+	if err := wmi.Query(wqlNetworkAdapter, &descs); err != nil {
+		return fmt.Errorf("WMI Win32_NetworkAdapter query failed: %w", err)
+	}
+
+	i.NICs = make([]*NIC, 0, len(descs))
+	for _, d := range descs {
+		nic := &NIC{
+			Name:       *d.Name,
+			MacAddress: *d.MACAddress,
+			IsVirtual:  d.PhysicalAdapter == nil || !*d.PhysicalAdapter,
+		}
+		if d.Speed != nil && *d.Speed > 0 {
+			nic.Speed = strconv.FormatUint(*d.Speed/1000000, 10)
+		}
+		i.NICs = append(i.NICs, nic)
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+// Package option contains the behavioural overrides that callers may pass
+// to any ghw subsystem's New() function.
+package option
+
+// defaultChroot is the root ghw backends use when none is supplied.
+const defaultChroot = "/"
+
+// Option carries a single behavioural override. Build one with the With*
+// constructors below rather than populating the struct directly, since its
+// fields may grow over time.
+type Option struct {
+	Chroot *string
+}
+
+// WithChroot instructs ghw to treat dir as the root filesystem when reading
+// from sysfs/procfs on Linux. This is primarily useful for tests that want
+// to point a backend at a captured snapshot instead of the live host.
+func WithChroot(dir string) *Option {
+	return &Option{Chroot: &dir}
+}
+
+// Merge combines zero or more Options into a single Option, with later
+// entries taking precedence, and fills in defaults for anything left
+// unset.
+func Merge(opts ...*Option) *Option {
+	merged := &Option{}
+	for _, opt := range opts {
+		if opt == nil {
+			continue
+		}
+		if opt.Chroot != nil {
+			merged.Chroot = opt.Chroot
+		}
+	}
+	if merged.Chroot == nil {
+		defChroot := defaultChroot
+		merged.Chroot = &defChroot
+	}
+	return merged
+}
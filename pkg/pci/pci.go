@@ -0,0 +1,34 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package pci
+
+import (
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/option"
+)
+
+// Device describes a single PCI device discovered on the host.
+type Device struct {
+	Address string `json:"address"`
+	Vendor  string `json:"vendor"`
+	Product string `json:"product"`
+}
+
+// Info describes the PCI devices discovered on the host system.
+type Info struct {
+	Devices []*Device `json:"devices"`
+}
+
+// New returns an Info struct describing the PCI devices discovered on the
+// host system.
+func New(opts ...*option.Option) (*Info, error) {
+	ctx := context.New(opts...)
+	info := &Info{}
+	if err := info.load(ctx); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
@@ -0,0 +1,65 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package pci
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/linuxpath"
+)
+
+func (i *Info) load(ctx *context.Context) error {
+	paths := linuxpath.New(ctx)
+	i.Devices = devices(ctx, paths)
+	return nil
+}
+
+// devices walks paths.SysBusPCIDevices -- which honours the Context's
+// chroot -- and builds a Device for each entry found there.
+func devices(ctx *context.Context, paths *linuxpath.Paths) []*Device {
+	devices := make([]*Device, 0)
+
+	entries, err := ioutil.ReadDir(paths.SysBusPCIDevices)
+	if err != nil {
+		ctx.Warn("failed to read %s: %s", paths.SysBusPCIDevices, err)
+		return devices
+	}
+
+	for _, entry := range entries {
+		devices = append(devices, deviceForAddress(paths.SysBusPCIDevices, entry.Name()))
+	}
+	return devices
+}
+
+// deviceForAddress reads the sysfs attributes for a single
+// /sys/bus/pci/devices entry and returns the Device describing it.
+//
+// Vendor and Product are the raw hex vendor/device IDs sysfs reports, not
+// human-readable names -- resolving those requires a pci.ids database
+// ghw doesn't ship, so callers wanting names will need to look these IDs
+// up themselves.
+func deviceForAddress(sysBusPCIDevices, address string) *Device {
+	devPath := filepath.Join(sysBusPCIDevices, address)
+
+	return &Device{
+		Address: address,
+		Vendor:  readSysfsHexID(filepath.Join(devPath, "vendor")),
+		Product: readSysfsHexID(filepath.Join(devPath, "device")),
+	}
+}
+
+// readSysfsHexID returns the named sysfs file's contents with the leading
+// "0x" and any trailing newline trimmed, or "" if it can't be read.
+func readSysfsHexID(path string) string {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.TrimSpace(string(contents)), "0x")
+}
@@ -0,0 +1,34 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package product
+
+import (
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/option"
+)
+
+// Info describes the overall product (the system, as opposed to an
+// individual component) the host is running as.
+type Info struct {
+	Family       string `json:"family"`
+	Name         string `json:"name"`
+	Vendor       string `json:"vendor"`
+	SerialNumber string `json:"serial_number"`
+	UUID         string `json:"uuid"`
+	SKU          string `json:"sku"`
+	Version      string `json:"version"`
+}
+
+// New returns an Info struct describing the product the host is running
+// as.
+func New(opts ...*option.Option) (*Info, error) {
+	ctx := context.New(opts...)
+	info := &Info{}
+	if err := info.load(ctx); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
@@ -0,0 +1,41 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package product
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/linuxpath"
+)
+
+// load populates Info by reading /sys/class/dmi/id, the kernel's own
+// decoding of the SMBIOS Type 1 (System Information) structure.
+func (i *Info) load(ctx *context.Context) error {
+	paths := linuxpath.New(ctx)
+	i.Vendor = readDMIAttr(paths, "sys_vendor")
+	i.Name = readDMIAttr(paths, "product_name")
+	i.Version = readDMIAttr(paths, "product_version")
+	i.SerialNumber = readDMIAttr(paths, "product_serial")
+	i.UUID = readDMIAttr(paths, "product_uuid")
+	i.SKU = readDMIAttr(paths, "product_sku")
+	i.Family = readDMIAttr(paths, "product_family")
+	return nil
+}
+
+// readDMIAttr returns the contents of the named attribute under
+// paths.SysClassDMIID, or "" if it doesn't exist or can't be read --
+// hosts without DMI support (VMs using some hypervisors, some ARM boards)
+// simply won't expose these files.
+func readDMIAttr(paths *linuxpath.Paths, name string) string {
+	contents, err := ioutil.ReadFile(filepath.Join(paths.SysClassDMIID, name))
+	if err != nil {
+		return ""
+	}
+	return strings.TrimSpace(string(contents))
+}
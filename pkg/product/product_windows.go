@@ -0,0 +1,111 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package product
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/StackExchange/wmi"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/smbios"
+)
+
+const wqlComputerSystemProduct = "SELECT IdentifyingNumber, Name, Vendor, UUID, SKUNumber, Version FROM Win32_ComputerSystemProduct"
+
+type win32ComputerSystemProduct struct {
+	IdentifyingNumber *string
+	Name              *string
+	Vendor            *string
+	UUID              *string
+	SKUNumber         *string
+	Version           *string
+}
+
+func (i *Info) load(ctx *context.Context) error {
+	if err := i.loadSMBIOS(); err == nil {
+		return nil
+	}
+	return i.loadWMI()
+}
+
+// loadSMBIOS populates Info by decoding the Type 1 (System Information)
+// structure from the raw SMBIOS table.
+func (i *Info) loadSMBIOS() error {
+	raw, err := smbios.FetchRawTable()
+	if err != nil {
+		return err
+	}
+	structures, err := smbios.ParseTable(raw)
+	if err != nil {
+		return err
+	}
+	s, found := smbios.Find(structures, smbios.TypeSystem)
+	if !found {
+		return fmt.Errorf("no SMBIOS Type 1 (System Information) structure found")
+	}
+
+	f := s.Formatted
+	if len(f) < 4 {
+		return fmt.Errorf("SMBIOS Type 1 structure is too short to decode")
+	}
+	i.Vendor = s.String(int(f[0]))
+	i.Name = s.String(int(f[1]))
+	i.Version = s.String(int(f[2]))
+	i.SerialNumber = s.String(int(f[3]))
+	if len(f) >= 20 {
+		i.UUID = formatSMBIOSUUID(f[4:20])
+	}
+	if len(f) > 21 {
+		i.SKU = s.String(int(f[21]))
+	}
+	if len(f) > 22 {
+		i.Family = s.String(int(f[22]))
+	}
+	return nil
+}
+
+// formatSMBIOSUUID renders a 16-byte SMBIOS System UUID as a standard
+// 8-4-4-4-12 UUID string. The first three fields (time-low, time-mid,
+// time-hi-and-version) are stored little-endian in the SMBIOS table and
+// must be byte-swapped to match the big-endian wire format every other
+// tool (dmidecode, PowerShell) reports.
+func formatSMBIOSUUID(b []byte) string {
+	swapped := []byte{
+		b[3], b[2], b[1], b[0],
+		b[5], b[4],
+		b[7], b[6],
+		b[8], b[9], b[10], b[11], b[12], b[13], b[14], b[15],
+	}
+	return fmt.Sprintf("%s-%s-%s-%s-%s",
+		hex.EncodeToString(swapped[0:4]),
+		hex.EncodeToString(swapped[4:6]),
+		hex.EncodeToString(swapped[6:8]),
+		hex.EncodeToString(swapped[8:10]),
+		hex.EncodeToString(swapped[10:16]),
+	)
+}
+
+// loadWMI populates Info from Win32_ComputerSystemProduct, used when the
+// SMBIOS firmware table is unreachable. WMI reports UUID already
+// formatted, so unlike loadSMBIOS there's no byte-swapping to do here.
+func (i *Info) loadWMI() error {
+	var descs []win32ComputerSystemProduct
+	if err := wmi.Query(wqlComputerSystemProduct, &descs); err != nil {
+		return err
+	}
+	if len(descs) == 0 {
+		return fmt.Errorf("WMI Win32_ComputerSystemProduct query returned no rows")
+	}
+	i.SerialNumber = *descs[0].IdentifyingNumber
+	i.Name = *descs[0].Name
+	i.Vendor = *descs[0].Vendor
+	i.UUID = *descs[0].UUID
+	i.SKU = *descs[0].SKUNumber
+	i.Version = *descs[0].Version
+	return nil
+}
@@ -0,0 +1,126 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+// Package smbios provides a minimal decoder for the SMBIOS/DMI structure
+// table. ghw's Windows backends use it to read BIOS, system, baseboard,
+// chassis and processor information straight from firmware, the same data
+// the Linux backends get for free from /sys/class/dmi/id.
+package smbios
+
+import (
+	"encoding/binary"
+	"errors"
+	"strings"
+)
+
+// Structure type codes for the SMBIOS records ghw decodes. See the SMBIOS
+// Reference Specification, table "SMBIOS Structure Types".
+const (
+	TypeBIOS       = 0
+	TypeSystem     = 1
+	TypeBaseboard  = 2
+	TypeChassis    = 3
+	TypeProcessor  = 4
+	TypeEndOfTable = 127
+)
+
+// ErrTableTruncated is returned when a structure's header claims more
+// bytes than remain in the raw table.
+var ErrTableTruncated = errors.New("smbios: structure table truncated")
+
+// Structure is a single decoded SMBIOS structure: its formatted area --
+// indexed from the byte following the Type/Length/Handle header, matching
+// the offsets in the SMBIOS spec minus 4 -- plus the unformatted string
+// table that follows it.
+type Structure struct {
+	Type      uint8
+	Handle    uint16
+	Formatted []byte
+	Strings   []string
+}
+
+// String returns the i'th (1-indexed, per the SMBIOS spec) string
+// referenced by the structure's formatted area, or "" if i is 0 (meaning
+// "no string") or out of range.
+func (s *Structure) String(i int) string {
+	if i <= 0 || i > len(s.Strings) {
+		return ""
+	}
+	return s.Strings[i-1]
+}
+
+// ParseTable walks a raw SMBIOS structure table -- as returned by
+// GetSystemFirmwareTable('RSMB', ...) on Windows, or read from
+// /sys/firmware/dmi/tables/DMI on Linux -- and returns each decoded
+// Structure in table order, stopping at (and including) the type 127
+// end-of-table marker.
+func ParseTable(raw []byte) ([]Structure, error) {
+	var structures []Structure
+	for len(raw) > 0 {
+		if len(raw) < 4 {
+			return nil, ErrTableTruncated
+		}
+		length := int(raw[1])
+		if length < 4 || len(raw) < length {
+			return nil, ErrTableTruncated
+		}
+
+		s := Structure{
+			Type:      raw[0],
+			Handle:    binary.LittleEndian.Uint16(raw[2:4]),
+			Formatted: raw[4:length],
+		}
+
+		// The formatted area is followed by the structure's string table:
+		// zero or more NUL-terminated strings, the whole set terminated by
+		// an extra NUL byte. That means the string table always ends in
+		// two consecutive NUL bytes -- find the first such pair and split
+		// everything before it on NUL to recover the individual strings.
+		rest := raw[length:]
+		boundary := 0
+		for {
+			if boundary+1 >= len(rest) {
+				return nil, ErrTableTruncated
+			}
+			if rest[boundary] == 0 && rest[boundary+1] == 0 {
+				break
+			}
+			boundary++
+		}
+		if boundary > 0 {
+			s.Strings = strings.Split(string(rest[:boundary]), "\x00")
+		}
+
+		structures = append(structures, s)
+		if s.Type == TypeEndOfTable {
+			break
+		}
+		raw = rest[boundary+2:]
+	}
+	return structures, nil
+}
+
+// Find returns the first Structure of the given type, and whether one was
+// found.
+func Find(structures []Structure, typ uint8) (Structure, bool) {
+	for _, s := range structures {
+		if s.Type == typ {
+			return s, true
+		}
+	}
+	return Structure{}, false
+}
+
+// FindAll returns every Structure of the given type, in table order. Types
+// such as Processor Information (4) commonly repeat once per socket.
+func FindAll(structures []Structure, typ uint8) []Structure {
+	var found []Structure
+	for _, s := range structures {
+		if s.Type == typ {
+			found = append(found, s)
+		}
+	}
+	return found
+}
@@ -0,0 +1,133 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package smbios_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/jaypipes/ghw/pkg/smbios"
+)
+
+// buildStructure assembles the raw bytes of a single SMBIOS structure:
+// header, formatted area, and string table, mirroring the layout
+// ParseTable decodes.
+func buildStructure(typ uint8, handle uint16, formatted []byte, strs []string) []byte {
+	length := 4 + len(formatted)
+	raw := []byte{typ, byte(length), byte(handle), byte(handle >> 8)}
+	raw = append(raw, formatted...)
+	if len(strs) == 0 {
+		return append(raw, 0, 0)
+	}
+	for _, s := range strs {
+		raw = append(raw, []byte(s)...)
+		raw = append(raw, 0)
+	}
+	return append(raw, 0)
+}
+
+func TestParseTableWithStrings(t *testing.T) {
+	raw := buildStructure(smbios.TypeBIOS, 0x0001, []byte{1, 2}, []string{"Acme Inc.", "1.0"})
+	raw = append(raw, buildStructure(smbios.TypeEndOfTable, 0x0002, nil, nil)...)
+
+	structures, err := smbios.ParseTable(raw)
+	if err != nil {
+		t.Fatalf("Expected nil err, but got %v", err)
+	}
+	if len(structures) != 2 {
+		t.Fatalf("Expected 2 structures, but got %d", len(structures))
+	}
+
+	s := structures[0]
+	if s.Type != smbios.TypeBIOS || s.Handle != 0x0001 {
+		t.Errorf("Expected type %d handle 0x0001, got type %d handle 0x%04x", smbios.TypeBIOS, s.Type, s.Handle)
+	}
+	if got, want := s.String(1), "Acme Inc."; got != want {
+		t.Errorf("Expected String(1) %q, got %q", want, got)
+	}
+	if got, want := s.String(2), "1.0"; got != want {
+		t.Errorf("Expected String(2) %q, got %q", want, got)
+	}
+	if got := s.String(0); got != "" {
+		t.Errorf("Expected String(0) to be empty, got %q", got)
+	}
+	if got := s.String(3); got != "" {
+		t.Errorf("Expected out-of-range String(3) to be empty, got %q", got)
+	}
+
+	if structures[1].Type != smbios.TypeEndOfTable {
+		t.Errorf("Expected the second structure to be the end-of-table marker, got type %d", structures[1].Type)
+	}
+}
+
+func TestParseTableEmptyStringTable(t *testing.T) {
+	raw := buildStructure(smbios.TypeBaseboard, 0x0001, []byte{0, 0}, nil)
+	raw = append(raw, buildStructure(smbios.TypeEndOfTable, 0x0002, nil, nil)...)
+
+	structures, err := smbios.ParseTable(raw)
+	if err != nil {
+		t.Fatalf("Expected nil err, but got %v", err)
+	}
+	if len(structures) != 2 {
+		t.Fatalf("Expected 2 structures, but got %d", len(structures))
+	}
+	if len(structures[0].Strings) != 0 {
+		t.Errorf("Expected no strings, but got %v", structures[0].Strings)
+	}
+}
+
+func TestParseTableTruncated(t *testing.T) {
+	tests := []struct {
+		name string
+		raw  []byte
+	}{
+		{
+			name: "header shorter than 4 bytes",
+			raw:  []byte{smbios.TypeBIOS, 4, 0},
+		},
+		{
+			name: "length claims more bytes than remain",
+			raw:  []byte{smbios.TypeBIOS, 10, 0, 0, 1, 2},
+		},
+		{
+			name: "string table never reaches a double NUL",
+			raw:  append(buildStructure(smbios.TypeBIOS, 0, nil, []string{"Acme"})[:5], 'x'),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := smbios.ParseTable(tt.raw)
+			if !errors.Is(err, smbios.ErrTableTruncated) {
+				t.Fatalf("Expected ErrTableTruncated, but got %v", err)
+			}
+		})
+	}
+}
+
+func TestFindAndFindAll(t *testing.T) {
+	raw := buildStructure(smbios.TypeProcessor, 1, nil, nil)
+	raw = append(raw, buildStructure(smbios.TypeProcessor, 2, nil, nil)...)
+	raw = append(raw, buildStructure(smbios.TypeEndOfTable, 3, nil, nil)...)
+
+	structures, err := smbios.ParseTable(raw)
+	if err != nil {
+		t.Fatalf("Expected nil err, but got %v", err)
+	}
+
+	if _, found := smbios.Find(structures, smbios.TypeChassis); found {
+		t.Errorf("Expected no Type 3 structure, but found one")
+	}
+	s, found := smbios.Find(structures, smbios.TypeProcessor)
+	if !found || s.Handle != 1 {
+		t.Fatalf("Expected the first Type 4 structure (handle 1), got found=%v handle=%d", found, s.Handle)
+	}
+
+	all := smbios.FindAll(structures, smbios.TypeProcessor)
+	if len(all) != 2 {
+		t.Fatalf("Expected 2 Type 4 structures, but got %d", len(all))
+	}
+}
@@ -0,0 +1,75 @@
+//go:build windows
+
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package smbios
+
+import (
+	"encoding/binary"
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+// firmwareTableProviderRSMB is the 'RSMB' provider signature
+// GetSystemFirmwareTable uses to request the raw SMBIOS structure table.
+var firmwareTableProviderRSMB = binary.LittleEndian.Uint32([]byte("RSMB"))
+
+// rawSMBIOSDataHeaderLen is the size, in bytes, of the RawSMBIOSData
+// header Windows prepends to the buffer returned for the 'RSMB' provider,
+// ahead of the structure table itself.
+const rawSMBIOSDataHeaderLen = 8
+
+// kernel32 and procGetSystemFirmwareTable resolve GetSystemFirmwareTable
+// manually -- x/sys/windows doesn't wrap this kernel32 API -- following
+// the same NewLazySystemDLL/NewProc pattern the package uses for every
+// other syscall it doesn't have a typed wrapper for.
+var (
+	kernel32                   = windows.NewLazySystemDLL("kernel32.dll")
+	procGetSystemFirmwareTable = kernel32.NewProc("GetSystemFirmwareTable")
+)
+
+// getSystemFirmwareTable calls the kernel32 GetSystemFirmwareTable
+// function, writing into buf (or, if buf is empty, just returning the
+// size needed). It returns the number of bytes GetSystemFirmwareTable
+// reports, which is either the size written or the required buffer size.
+func getSystemFirmwareTable(providerSignature, tableID uint32, buf []byte) (uint32, error) {
+	var bufPtr unsafe.Pointer
+	if len(buf) > 0 {
+		bufPtr = unsafe.Pointer(&buf[0])
+	}
+	r, _, err := procGetSystemFirmwareTable.Call(
+		uintptr(providerSignature),
+		uintptr(tableID),
+		uintptr(bufPtr),
+		uintptr(len(buf)),
+	)
+	if r == 0 {
+		return 0, fmt.Errorf("GetSystemFirmwareTable: %w", err)
+	}
+	return uint32(r), nil
+}
+
+// FetchRawTable retrieves the raw SMBIOS structure table from firmware via
+// GetSystemFirmwareTable('RSMB', ...), stripping the RawSMBIOSData header
+// Windows prepends to it.
+func FetchRawTable() ([]byte, error) {
+	size, err := getSystemFirmwareTable(firmwareTableProviderRSMB, 0, nil)
+	if err != nil {
+		return nil, fmt.Errorf("GetSystemFirmwareTable size query failed: %w", err)
+	}
+
+	buf := make([]byte, size)
+	n, err := getSystemFirmwareTable(firmwareTableProviderRSMB, 0, buf)
+	if err != nil {
+		return nil, fmt.Errorf("GetSystemFirmwareTable fetch failed: %w", err)
+	}
+	if n < rawSMBIOSDataHeaderLen {
+		return nil, fmt.Errorf("GetSystemFirmwareTable returned a buffer too small to contain a RawSMBIOSData header")
+	}
+	return buf[rawSMBIOSDataHeaderLen:n], nil
+}
@@ -0,0 +1,33 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package topology
+
+import (
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/option"
+)
+
+// Node describes a single NUMA node on the host.
+type Node struct {
+	ID int `json:"id"`
+}
+
+// Info describes the NUMA/SMP topology of the host system.
+type Info struct {
+	Architecture string  `json:"architecture"`
+	Nodes        []*Node `json:"nodes"`
+}
+
+// New returns an Info struct describing the NUMA/SMP topology of the host
+// system.
+func New(opts ...*option.Option) (*Info, error) {
+	ctx := context.New(opts...)
+	info := &Info{}
+	if err := info.load(ctx); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
@@ -0,0 +1,54 @@
+// Use and distribution licensed under the Apache license version 2.
+//
+// See the COPYING file in the root project directory for full text.
+//
+
+package topology
+
+import (
+	"io/ioutil"
+	"strconv"
+	"strings"
+
+	"github.com/jaypipes/ghw/pkg/context"
+	"github.com/jaypipes/ghw/pkg/linuxpath"
+)
+
+func (i *Info) load(ctx *context.Context) error {
+	paths := linuxpath.New(ctx)
+	i.Nodes = nodes(ctx, paths)
+	if len(i.Nodes) > 1 {
+		i.Architecture = "NUMA"
+	} else {
+		i.Architecture = "SMP"
+	}
+	return nil
+}
+
+// nodes walks paths.SysDevicesSystemNode -- which honours the Context's
+// chroot -- and builds a Node for each "nodeN" entry found there. Hosts
+// without NUMA support don't expose this directory at all, in which case
+// nodes returns an empty slice and load treats the host as a single SMP
+// node.
+func nodes(ctx *context.Context, paths *linuxpath.Paths) []*Node {
+	nodes := make([]*Node, 0)
+
+	entries, err := ioutil.ReadDir(paths.SysDevicesSystemNode)
+	if err != nil {
+		ctx.Warn("failed to read %s: %s", paths.SysDevicesSystemNode, err)
+		return nodes
+	}
+
+	for _, entry := range entries {
+		idxStr := strings.TrimPrefix(entry.Name(), "node")
+		if idxStr == entry.Name() {
+			continue
+		}
+		id, err := strconv.Atoi(idxStr)
+		if err != nil {
+			continue
+		}
+		nodes = append(nodes, &Node{ID: id})
+	}
+	return nodes
+}